@@ -76,6 +76,10 @@ func (n NoopVerifier) NoError(t TestingT, err error, msgAndArgs ...interface{})
 	return true
 }
 
+// Assert is a function that allows extending apitest with custom assertions against the
+// raw response and request. Returning an error fails the response assertion chain.
+type Assert func(*http.Response, *http.Request) error
+
 // IsSuccess is a convenience function to assert on a range of happy path status codes
 var IsSuccess Assert = func(response *http.Response, request *http.Request) error {
 	if response.StatusCode >= 200 && response.StatusCode < 400 {
@@ -0,0 +1,142 @@
+package apitest
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/clientcredentials"
+)
+
+// Authenticator attaches credentials to an outgoing request, e.g. an OAuth2
+// bearer token. It is applied via WithAuth once the request has otherwise
+// been fully configured (headers, body, query params).
+type Authenticator interface {
+	Apply(req *http.Request) error
+}
+
+// AuthenticatorFunc adapts a plain function to an Authenticator.
+type AuthenticatorFunc func(req *http.Request) error
+
+// Apply calls f(req)
+func (f AuthenticatorFunc) Apply(req *http.Request) error {
+	return f(req)
+}
+
+// WithAuth attaches an Authenticator that is applied to every outgoing request.
+func WithAuth(authenticator Authenticator) Option {
+	return func(a *APITest) {
+		a.auth = authenticator
+	}
+}
+
+// BearerToken returns an Authenticator that sets a static
+// "Authorization: Bearer <token>" header on every request.
+func BearerToken(token string) Authenticator {
+	return AuthenticatorFunc(func(req *http.Request) error {
+		req.Header.Set("Authorization", "Bearer "+token)
+		return nil
+	})
+}
+
+// ClientCredentials returns an Authenticator that obtains an OAuth2 access
+// token via the client credentials grant and attaches it as a bearer token.
+// The underlying token source caches the token and only hits tokenURL again
+// once it expires, so the cost is amortized across the steps of a test.
+func ClientCredentials(tokenURL, clientID, clientSecret string, scopes ...string) Authenticator {
+	cfg := clientcredentials.Config{
+		ClientID:     clientID,
+		ClientSecret: clientSecret,
+		TokenURL:     tokenURL,
+		Scopes:       scopes,
+	}
+	tokenSource := cfg.TokenSource(context.Background())
+
+	return AuthenticatorFunc(func(req *http.Request) error {
+		token, err := tokenSource.Token()
+		if err != nil {
+			return fmt.Errorf("apitest: failed to obtain client credentials token: %w", err)
+		}
+		token.SetAuthHeader(req)
+		return nil
+	})
+}
+
+// oidcDiscoveryDocument is the subset of the OIDC discovery document
+// (.well-known/openid-configuration) apitest needs to perform a ROPC grant.
+type oidcDiscoveryDocument struct {
+	TokenEndpoint string `json:"token_endpoint"`
+}
+
+// OIDCPassword discovers the token endpoint from issuerURL's OIDC discovery
+// document and returns an Authenticator that authenticates via the resource
+// owner password credentials (ROPC) grant, attaching the resulting access
+// token as a bearer token on every request. Like ClientCredentials, the token
+// is cached and the ROPC grant only runs again once it expires.
+func OIDCPassword(issuerURL, clientID, clientSecret, username, password string) (Authenticator, error) {
+	discoveryURL := strings.TrimSuffix(issuerURL, "/") + "/.well-known/openid-configuration"
+
+	resp, err := http.Get(discoveryURL)
+	if err != nil {
+		return nil, fmt.Errorf("apitest: failed to fetch OIDC discovery document: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("apitest: OIDC discovery document request to %s returned status %d", discoveryURL, resp.StatusCode)
+	}
+
+	var doc oidcDiscoveryDocument
+	if err := json.NewDecoder(resp.Body).Decode(&doc); err != nil {
+		return nil, fmt.Errorf("apitest: failed to decode OIDC discovery document: %w", err)
+	}
+
+	cfg := oauth2.Config{
+		ClientID:     clientID,
+		ClientSecret: clientSecret,
+		Endpoint:     oauth2.Endpoint{TokenURL: doc.TokenEndpoint},
+	}
+	tokenSource := oauth2.ReuseTokenSource(nil, &ropcTokenSource{cfg: cfg, username: username, password: password})
+
+	return AuthenticatorFunc(func(req *http.Request) error {
+		token, err := tokenSource.Token()
+		if err != nil {
+			return fmt.Errorf("apitest: failed to obtain ROPC token: %w", err)
+		}
+		token.SetAuthHeader(req)
+		return nil
+	}), nil
+}
+
+// ropcTokenSource performs the resource owner password credentials grant on
+// every call to Token. It is meant to be wrapped in oauth2.ReuseTokenSource
+// so the grant only runs again once the previously issued token expires,
+// matching the caching behaviour of ClientCredentials.
+type ropcTokenSource struct {
+	cfg      oauth2.Config
+	username string
+	password string
+}
+
+func (r *ropcTokenSource) Token() (*oauth2.Token, error) {
+	return r.cfg.PasswordCredentialsToken(context.Background(), r.username, r.password)
+}
+
+// IsUnauthorized is a convenience function to assert on a 401 status code
+var IsUnauthorized Assert = func(response *http.Response, request *http.Request) error {
+	if response.StatusCode == http.StatusUnauthorized {
+		return nil
+	}
+	return fmt.Errorf("not unauthorized. Status code=%d", response.StatusCode)
+}
+
+// IsForbidden is a convenience function to assert on a 403 status code
+var IsForbidden Assert = func(response *http.Response, request *http.Request) error {
+	if response.StatusCode == http.StatusForbidden {
+		return nil
+	}
+	return fmt.Errorf("not forbidden. Status code=%d", response.StatusCode)
+}
@@ -0,0 +1,133 @@
+package apitest
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+)
+
+func TestBearerToken(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "http://example.com", nil)
+	if err := BearerToken("tok-123").Apply(req); err != nil {
+		t.Fatalf("Apply() error = %v", err)
+	}
+	if got := req.Header.Get("Authorization"); got != "Bearer tok-123" {
+		t.Fatalf("expected bearer header, got %q", got)
+	}
+}
+
+func TestClientCredentials_CachesToken(t *testing.T) {
+	var hits int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&hits, 1)
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"access_token": "tok",
+			"token_type":   "Bearer",
+			"expires_in":   3600,
+		})
+	}))
+	defer srv.Close()
+
+	auth := ClientCredentials(srv.URL, "client-id", "client-secret", "scope1")
+
+	for i := 0; i < 3; i++ {
+		req := httptest.NewRequest(http.MethodGet, "http://example.com", nil)
+		if err := auth.Apply(req); err != nil {
+			t.Fatalf("Apply() error = %v", err)
+		}
+		if got := req.Header.Get("Authorization"); !strings.HasPrefix(got, "Bearer ") {
+			t.Fatalf("expected bearer token header, got %q", got)
+		}
+	}
+	if hits != 1 {
+		t.Fatalf("expected token endpoint to be hit once due to caching, got %d hits", hits)
+	}
+}
+
+func TestOIDCPassword_CachesToken(t *testing.T) {
+	var hits int32
+	var srv *httptest.Server
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]string{"token_endpoint": srv.URL + "/token"})
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&hits, 1)
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"access_token": "tok",
+			"token_type":   "Bearer",
+			"expires_in":   3600,
+		})
+	})
+	srv = httptest.NewServer(mux)
+	defer srv.Close()
+
+	auth, err := OIDCPassword(srv.URL, "client-id", "client-secret", "user", "pass")
+	if err != nil {
+		t.Fatalf("OIDCPassword() error = %v", err)
+	}
+
+	for i := 0; i < 3; i++ {
+		req := httptest.NewRequest(http.MethodGet, "http://example.com", nil)
+		if err := auth.Apply(req); err != nil {
+			t.Fatalf("Apply() error = %v", err)
+		}
+	}
+	if hits != 1 {
+		t.Fatalf("expected ROPC grant to run once due to caching, got %d hits", hits)
+	}
+}
+
+func TestWithAuth_AppliedByAPITestExpect(t *testing.T) {
+	var gotAuth string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotAuth = r.Header.Get("Authorization")
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	New(WithAuth(BearerToken("tok-456"))).
+		Method(http.MethodGet).
+		URL(srv.URL).
+		Expect(t)
+
+	if gotAuth != "Bearer tok-456" {
+		t.Fatalf("expected WithAuth to apply the bearer token to the outgoing request, got Authorization=%q", gotAuth)
+	}
+}
+
+func TestOIDCPassword_DiscoveryNonOKStatus(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte("<html>not found</html>"))
+	}))
+	defer srv.Close()
+
+	_, err := OIDCPassword(srv.URL, "client-id", "client-secret", "user", "pass")
+	if err == nil {
+		t.Fatal("expected a non-200 discovery response to return an error")
+	}
+	if !strings.Contains(err.Error(), "404") {
+		t.Fatalf("expected error to mention the discovery response status code, got %q", err)
+	}
+}
+
+func TestIsUnauthorizedAndIsForbidden(t *testing.T) {
+	if err := IsUnauthorized(&http.Response{StatusCode: http.StatusUnauthorized}, nil); err != nil {
+		t.Fatalf("expected 401 to pass IsUnauthorized, got %v", err)
+	}
+	if err := IsUnauthorized(&http.Response{StatusCode: http.StatusOK}, nil); err == nil {
+		t.Fatal("expected 200 to fail IsUnauthorized")
+	}
+	if err := IsForbidden(&http.Response{StatusCode: http.StatusForbidden}, nil); err != nil {
+		t.Fatalf("expected 403 to pass IsForbidden, got %v", err)
+	}
+	if err := IsForbidden(&http.Response{StatusCode: http.StatusOK}, nil); err == nil {
+		t.Fatal("expected 200 to fail IsForbidden")
+	}
+}
@@ -0,0 +1,118 @@
+package apitest
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"gotest.tools/v3/assert"
+	"gotest.tools/v3/assert/cmp"
+)
+
+// Comparator is implemented by verifiers that can assert an arbitrary
+// gotest.tools/v3/assert/cmp.Comparison, e.g. is.Contains, is.ErrorContains or
+// is.DeepEqual. Consumers type-assert a Verifier to Comparator to use it.
+type Comparator interface {
+	Compare(t TestingT, comparison cmp.Comparison, msgAndArgs ...interface{}) bool
+}
+
+// gotestVerifier is a verifier that uses https://github.com/gotestyourself/gotest.tools
+// to perform assertions, for consumers who have standardized on gotest.tools instead of testify.
+type gotestVerifier struct{}
+
+var _ Verifier = gotestVerifier{}
+var _ Comparator = gotestVerifier{}
+
+func newGotestVerifier() Verifier {
+	return gotestVerifier{}
+}
+
+// WithGotestVerifier selects gotest.tools/v3/assert as the underlying Verifier.
+// The returned Verifier also implements Comparator, so it can be type-asserted
+// to run arbitrary cmp.Comparison assertions against the response chain.
+func WithGotestVerifier() Option {
+	return WithVerifier(newGotestVerifier())
+}
+
+// gotestT adapts apitest's TestingT to gotest.tools/v3/assert's TestingT
+// interface, which additionally requires Fail, FailNow and Log. The failure
+// message gotest.tools reports via Log is buffered and only surfaced once
+// Fail/FailNow is called, mirroring how assert.Check/assert.Assert use them.
+type gotestT struct {
+	TestingT
+	msg string
+}
+
+func (g *gotestT) Log(args ...interface{}) {
+	g.msg = fmt.Sprint(args...)
+}
+
+func (g *gotestT) Fail() {
+	g.Errorf("%s", g.msg)
+}
+
+func (g *gotestT) FailNow() {
+	g.Fatal(g.msg)
+}
+
+// formatMsgAndArgs mirrors testify's optional msgAndArgs convention: a single
+// string is used verbatim, any other single value is rendered with %+v, a
+// string followed by args is treated as a Printf format, and anything else is
+// rendered with fmt.Sprint. It never substitutes
+// into the assertion's own failure message, so neither can garble the other.
+func formatMsgAndArgs(msgAndArgs ...interface{}) string {
+	switch len(msgAndArgs) {
+	case 0:
+		return ""
+	case 1:
+		if msg, ok := msgAndArgs[0].(string); ok {
+			return msg
+		}
+		return fmt.Sprintf("%+v", msgAndArgs[0])
+	default:
+		if format, ok := msgAndArgs[0].(string); ok {
+			return fmt.Sprintf(format, msgAndArgs[1:]...)
+		}
+		return fmt.Sprint(msgAndArgs...)
+	}
+}
+
+// JSONEq asserts that two JSON strings are equivalent
+func (g gotestVerifier) JSONEq(t TestingT, expected string, actual string, msgAndArgs ...interface{}) bool {
+	var expectedJSON, actualJSON interface{}
+	if err := json.Unmarshal([]byte(expected), &expectedJSON); err != nil {
+		return g.Fail(t, fmt.Sprintf("expected value is not valid JSON: %s", err), msgAndArgs...)
+	}
+	if err := json.Unmarshal([]byte(actual), &actualJSON); err != nil {
+		return g.Fail(t, fmt.Sprintf("actual value is not valid JSON: %s", err), msgAndArgs...)
+	}
+	return assert.Check(&gotestT{TestingT: t}, cmp.DeepEqual(actualJSON, expectedJSON), msgAndArgs...)
+}
+
+// Equal asserts that two objects are equal
+func (g gotestVerifier) Equal(t TestingT, expected, actual interface{}, msgAndArgs ...interface{}) bool {
+	return assert.Check(&gotestT{TestingT: t}, cmp.Equal(actual, expected), msgAndArgs...)
+}
+
+// Fail reports a failure
+func (g gotestVerifier) Fail(t TestingT, failureMessage string, msgAndArgs ...interface{}) bool {
+	if extra := formatMsgAndArgs(msgAndArgs...); extra != "" {
+		t.Errorf("%s: %s", failureMessage, extra)
+	} else {
+		t.Errorf("%s", failureMessage)
+	}
+	return false
+}
+
+// NoError asserts that a function returned no error
+func (g gotestVerifier) NoError(t TestingT, err error, msgAndArgs ...interface{}) bool {
+	if err != nil {
+		return g.Fail(t, fmt.Sprintf("expected no error, got %v", err), msgAndArgs...)
+	}
+	return true
+}
+
+// Compare asserts an arbitrary cmp.Comparison, e.g. is.Contains(body, "..."),
+// is.ErrorContains(err, "...") or is.DeepEqual(expected, actual)
+func (g gotestVerifier) Compare(t TestingT, comparison cmp.Comparison, msgAndArgs ...interface{}) bool {
+	return assert.Check(&gotestT{TestingT: t}, comparison, msgAndArgs...)
+}
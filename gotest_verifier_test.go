@@ -0,0 +1,110 @@
+package apitest
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	is "gotest.tools/v3/assert/cmp"
+)
+
+type fakeT struct {
+	errors []string
+	fatals []string
+}
+
+func (f *fakeT) Errorf(format string, args ...interface{}) {
+	f.errors = append(f.errors, fmt.Sprintf(format, args...))
+}
+
+func (f *fakeT) Fatal(args ...interface{}) {
+	f.fatals = append(f.fatals, fmt.Sprint(args...))
+}
+
+func (f *fakeT) Fatalf(format string, args ...interface{}) {
+	f.fatals = append(f.fatals, fmt.Sprintf(format, args...))
+}
+
+func (f *fakeT) failed() bool {
+	return len(f.errors) > 0 || len(f.fatals) > 0
+}
+
+func TestGotestVerifier_Equal(t *testing.T) {
+	v := newGotestVerifier()
+
+	ft := &fakeT{}
+	if ok := v.Equal(ft, "foo", "foo"); !ok || ft.failed() {
+		t.Fatalf("expected equal strings to pass, got ok=%v failed=%v", ok, ft.failed())
+	}
+
+	ft = &fakeT{}
+	if ok := v.Equal(ft, "foo", "bar"); ok || !ft.failed() {
+		t.Fatalf("expected mismatched strings to fail, got ok=%v failed=%v", ok, ft.failed())
+	}
+}
+
+func TestGotestVerifier_JSONEq(t *testing.T) {
+	v := newGotestVerifier()
+
+	ft := &fakeT{}
+	if ok := v.JSONEq(ft, `{"a":1,"b":2}`, `{"b":2,"a":1}`); !ok || ft.failed() {
+		t.Fatalf("expected equivalent JSON to pass, got ok=%v failed=%v", ok, ft.failed())
+	}
+
+	ft = &fakeT{}
+	if ok := v.JSONEq(ft, `{"a":1}`, `{"a":2}`); ok || !ft.failed() {
+		t.Fatalf("expected differing JSON to fail, got ok=%v failed=%v", ok, ft.failed())
+	}
+}
+
+func TestGotestVerifier_NoError(t *testing.T) {
+	v := newGotestVerifier()
+
+	ft := &fakeT{}
+	if ok := v.NoError(ft, nil); !ok || ft.failed() {
+		t.Fatalf("expected nil error to pass, got ok=%v failed=%v", ok, ft.failed())
+	}
+
+	ft = &fakeT{}
+	if ok := v.NoError(ft, errors.New("boom")); ok || !ft.failed() {
+		t.Fatalf("expected non-nil error to fail, got ok=%v failed=%v", ok, ft.failed())
+	}
+}
+
+func TestGotestVerifier_Compare(t *testing.T) {
+	comparator, ok := newGotestVerifier().(Comparator)
+	if !ok {
+		t.Fatal("expected gotestVerifier to implement Comparator")
+	}
+
+	ft := &fakeT{}
+	if ok := comparator.Compare(ft, is.Contains("hello world", "world")); !ok || ft.failed() {
+		t.Fatalf("expected is.Contains to pass, got ok=%v failed=%v", ok, ft.failed())
+	}
+
+	ft = &fakeT{}
+	if ok := comparator.Compare(ft, is.Contains("hello world", "goodbye")); ok || !ft.failed() {
+		t.Fatalf("expected is.Contains to fail, got ok=%v failed=%v", ok, ft.failed())
+	}
+}
+
+func TestGotestVerifier_Fail_DoesNotGarbleMsgAndArgs(t *testing.T) {
+	v := newGotestVerifier()
+	ft := &fakeT{}
+
+	// failureMessage containing a literal "%" must not be treated as a format
+	// string for msgAndArgs, and msgAndArgs must still show up in the output.
+	if ok := v.Fail(ft, "got 50% discount, expected none", "extra context", 42); ok {
+		t.Fatal("expected Fail to always return false")
+	}
+	if len(ft.errors) != 1 {
+		t.Fatalf("expected exactly one recorded error, got %v", ft.errors)
+	}
+	if strings.Contains(ft.errors[0], "%!") {
+		t.Fatalf("failure message was garbled: %q", ft.errors[0])
+	}
+	if !strings.Contains(ft.errors[0], "50% discount") || !strings.Contains(ft.errors[0], "extra context") {
+		t.Fatalf("failure message missing expected content: %q", ft.errors[0])
+	}
+}
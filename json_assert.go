@@ -0,0 +1,123 @@
+package apitest
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"strings"
+
+	"github.com/PaesslerAG/jsonpath"
+	"github.com/xeipuuv/gojsonschema"
+)
+
+// readBody reads and returns the response body, restoring it afterwards so
+// later asserts in the same chain can still read it.
+func readBody(response *http.Response) ([]byte, error) {
+	body, err := io.ReadAll(response.Body)
+	if err != nil {
+		return nil, err
+	}
+	response.Body = io.NopCloser(bytes.NewReader(body))
+	return body, nil
+}
+
+// MatchesJSONSchema returns an Assert that validates the response body against
+// a draft-07 JSON Schema, aggregating every validation error into a single
+// error rather than stopping at the first mismatch.
+func MatchesJSONSchema(schema string) Assert {
+	return func(response *http.Response, request *http.Request) error {
+		body, err := readBody(response)
+		if err != nil {
+			return fmt.Errorf("failed to read response body: %w", err)
+		}
+
+		result, err := gojsonschema.Validate(
+			gojsonschema.NewStringLoader(schema),
+			gojsonschema.NewBytesLoader(body),
+		)
+		if err != nil {
+			return fmt.Errorf("failed to validate response against schema: %w", err)
+		}
+		if result.Valid() {
+			return nil
+		}
+
+		errs := make([]string, 0, len(result.Errors()))
+		for _, e := range result.Errors() {
+			errs = append(errs, e.String())
+		}
+		return fmt.Errorf("response does not match JSON schema: %s", strings.Join(errs, "; "))
+	}
+}
+
+// JSONPathEqual returns an Assert that evaluates path against the response
+// body as JSON and asserts the result equals expected, for asserting on a
+// single field without requiring a full-body JSONEq.
+func JSONPathEqual(path string, expected interface{}) Assert {
+	return func(response *http.Response, request *http.Request) error {
+		actual, err := evalJSONPath(response, path)
+		if err != nil {
+			return err
+		}
+		normalizedExpected, err := normalizeJSONValue(expected)
+		if err != nil {
+			return fmt.Errorf("JSONPath %q: failed to normalize expected value: %w", path, err)
+		}
+		if !reflect.DeepEqual(actual, normalizedExpected) {
+			return fmt.Errorf("JSONPath %q: expected %v, got %v", path, expected, actual)
+		}
+		return nil
+	}
+}
+
+// normalizeJSONValue round-trips v through json.Marshal/json.Unmarshal so it
+// decodes into the same Go types evalJSONPath produces (e.g. float64 for
+// every JSON number), letting natural Go literals such as int(3) compare
+// correctly against a JSONPath result decoded from the response body.
+func normalizeJSONValue(v interface{}) (interface{}, error) {
+	encoded, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var normalized interface{}
+	if err := json.Unmarshal(encoded, &normalized); err != nil {
+		return nil, err
+	}
+	return normalized, nil
+}
+
+// JSONPathExists returns an Assert that fails unless path evaluates to a
+// result against the response body as JSON.
+func JSONPathExists(path string) Assert {
+	return func(response *http.Response, request *http.Request) error {
+		_, err := evalJSONPath(response, path)
+		return err
+	}
+}
+
+func evalJSONPath(response *http.Response, path string) (interface{}, error) {
+	body, err := readBody(response)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	var v interface{}
+	if err := json.Unmarshal(body, &v); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response body as JSON: %w", err)
+	}
+
+	eval, err := jsonpath.New(path)
+	if err != nil {
+		return nil, fmt.Errorf("invalid JSONPath %q: %w", path, err)
+	}
+
+	result, err := eval(context.Background(), v)
+	if err != nil {
+		return nil, fmt.Errorf("JSONPath %q did not match: %w", path, err)
+	}
+	return result, nil
+}
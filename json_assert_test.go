@@ -0,0 +1,55 @@
+package apitest
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func jsonResponse(body string) *http.Response {
+	return &http.Response{Body: io.NopCloser(strings.NewReader(body))}
+}
+
+func TestMatchesJSONSchema(t *testing.T) {
+	schema := `{
+		"type": "object",
+		"required": ["name"],
+		"properties": {"name": {"type": "string"}}
+	}`
+	assertFn := MatchesJSONSchema(schema)
+
+	if err := assertFn(jsonResponse(`{"name":"bob"}`), nil); err != nil {
+		t.Fatalf("expected valid body to pass, got %v", err)
+	}
+	if err := assertFn(jsonResponse(`{"age":30}`), nil); err == nil {
+		t.Fatal("expected missing required field to fail")
+	}
+}
+
+func TestJSONPathEqual(t *testing.T) {
+	body := `{"count": 3, "user": {"name": "bob"}}`
+
+	// expected as an untyped int literal must still match the float64 the
+	// JSON number decodes to.
+	if err := JSONPathEqual("$.count", 3)(jsonResponse(body), nil); err != nil {
+		t.Fatalf("expected int literal to match JSON number, got %v", err)
+	}
+	if err := JSONPathEqual("$.user.name", "bob")(jsonResponse(body), nil); err != nil {
+		t.Fatalf("expected nested path to match, got %v", err)
+	}
+	if err := JSONPathEqual("$.count", 4)(jsonResponse(body), nil); err == nil {
+		t.Fatal("expected mismatched value to fail")
+	}
+}
+
+func TestJSONPathExists(t *testing.T) {
+	body := `{"id": "abc-123"}`
+
+	if err := JSONPathExists("$.id")(jsonResponse(body), nil); err != nil {
+		t.Fatalf("expected existing path to pass, got %v", err)
+	}
+	if err := JSONPathExists("$.missing")(jsonResponse(body), nil); err == nil {
+		t.Fatal("expected missing path to fail")
+	}
+}
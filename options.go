@@ -0,0 +1,65 @@
+package apitest
+
+import "net/http"
+
+// Option configures an APITest during construction, e.g. selecting a Verifier
+// implementation or an Authenticator to apply to every outgoing request.
+type Option func(*APITest)
+
+// APITest is the request builder used to configure and run a single HTTP interaction.
+// auth, if set, is applied to the outgoing request once it is otherwise fully configured.
+type APITest struct {
+	verifier Verifier
+	auth     Authenticator
+	client   *http.Client
+
+	method string
+	url    string
+	header http.Header
+	body   string
+}
+
+// New creates an APITest with the given options applied on top of the defaults.
+func New(opts ...Option) *APITest {
+	a := &APITest{
+		verifier: newTestifyVerifier(),
+		method:   http.MethodGet,
+		header:   make(http.Header),
+	}
+	for _, opt := range opts {
+		opt(a)
+	}
+	return a
+}
+
+// Method sets the HTTP method of the request, e.g. http.MethodPost
+func (a *APITest) Method(method string) *APITest {
+	a.method = method
+	return a
+}
+
+// URL sets the target URL of the request
+func (a *APITest) URL(url string) *APITest {
+	a.url = url
+	return a
+}
+
+// Header adds a header to the request
+func (a *APITest) Header(key, value string) *APITest {
+	a.header.Add(key, value)
+	return a
+}
+
+// Body sets the request body
+func (a *APITest) Body(body string) *APITest {
+	a.body = body
+	return a
+}
+
+// WithVerifier overrides the default testify-backed Verifier, e.g. to opt into
+// RequireVerifier's fail-fast semantics.
+func WithVerifier(v Verifier) Option {
+	return func(a *APITest) {
+		a.verifier = v
+	}
+}
@@ -0,0 +1,17 @@
+package apitest
+
+import "testing"
+
+func TestNew_DefaultsToTestifyVerifier(t *testing.T) {
+	a := New()
+	if _, ok := a.verifier.(testifyVerifier); !ok {
+		t.Fatalf("expected default verifier to be testifyVerifier, got %T", a.verifier)
+	}
+}
+
+func TestWithVerifier_OverridesDefault(t *testing.T) {
+	a := New(WithVerifier(RequireVerifier{}))
+	if _, ok := a.verifier.(RequireVerifier); !ok {
+		t.Fatalf("expected WithVerifier to override the default, got %T", a.verifier)
+	}
+}
@@ -0,0 +1,48 @@
+package apitest
+
+import (
+	"github.com/stretchr/testify/require"
+)
+
+// RequireVerifier is a verifier that uses https://github.com/stretchr/testify/require
+// to perform assertions. Unlike testifyVerifier, a failed assertion calls
+// t.FailNow() immediately instead of accumulating further, likely cascading,
+// failures - e.g. asserting on response.Body after the status code assertion
+// has already failed. Select it via WithVerifier(RequireVerifier{}).
+type RequireVerifier struct{}
+
+var _ Verifier = RequireVerifier{}
+
+// requireT adapts apitest's TestingT to testify/require's TestingT interface,
+// which additionally requires FailNow.
+type requireT struct {
+	TestingT
+}
+
+func (r requireT) FailNow() {
+	r.Fatal("assertion failed")
+}
+
+// JSONEq asserts that two JSON strings are equivalent, aborting the test immediately otherwise
+func (r RequireVerifier) JSONEq(t TestingT, expected string, actual string, msgAndArgs ...interface{}) bool {
+	require.JSONEq(requireT{t}, expected, actual, msgAndArgs...)
+	return true
+}
+
+// Equal asserts that two objects are equal, aborting the test immediately otherwise
+func (r RequireVerifier) Equal(t TestingT, expected, actual interface{}, msgAndArgs ...interface{}) bool {
+	require.Equal(requireT{t}, expected, actual, msgAndArgs...)
+	return true
+}
+
+// Fail reports a failure and aborts the test immediately
+func (r RequireVerifier) Fail(t TestingT, failureMessage string, msgAndArgs ...interface{}) bool {
+	require.Fail(requireT{t}, failureMessage, msgAndArgs...)
+	return true
+}
+
+// NoError asserts that a function returned no error, aborting the test immediately otherwise
+func (r RequireVerifier) NoError(t TestingT, err error, msgAndArgs ...interface{}) bool {
+	require.NoError(requireT{t}, err, msgAndArgs...)
+	return true
+}
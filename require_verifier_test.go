@@ -0,0 +1,74 @@
+package apitest
+
+import "testing"
+
+// recordingT is a local TestingT fake for this file's tests, so they don't
+// depend on a fixture owned by a different request's test file.
+type recordingT struct {
+	errors []string
+	fatals []string
+}
+
+func (r *recordingT) Errorf(format string, args ...interface{}) {
+	r.errors = append(r.errors, format)
+}
+
+func (r *recordingT) Fatal(args ...interface{}) {
+	r.fatals = append(r.fatals, "fatal")
+}
+
+func (r *recordingT) Fatalf(format string, args ...interface{}) {
+	r.fatals = append(r.fatals, format)
+}
+
+func (r *recordingT) failed() bool {
+	return len(r.errors) > 0 || len(r.fatals) > 0
+}
+
+func TestRequireVerifier_Equal_PassesSilently(t *testing.T) {
+	v := RequireVerifier{}
+	rt := &recordingT{}
+
+	if ok := v.Equal(rt, "foo", "foo"); !ok || rt.failed() {
+		t.Fatalf("expected equal values to pass, got ok=%v failed=%v", ok, rt.failed())
+	}
+}
+
+func TestRequireVerifier_Equal_CallsFailNowOnMismatch(t *testing.T) {
+	v := RequireVerifier{}
+	rt := &recordingT{}
+
+	v.Equal(rt, "foo", "bar")
+
+	if len(rt.fatals) == 0 {
+		t.Fatal("expected a mismatched Equal to call FailNow (via Fatal) instead of just recording an error")
+	}
+}
+
+func TestRequireVerifier_JSONEq_CallsFailNowOnMismatch(t *testing.T) {
+	v := RequireVerifier{}
+	rt := &recordingT{}
+
+	v.JSONEq(rt, `{"a":1}`, `{"a":2}`)
+
+	if len(rt.fatals) == 0 {
+		t.Fatal("expected a mismatched JSONEq to call FailNow (via Fatal)")
+	}
+}
+
+func TestRequireVerifier_NoError_CallsFailNowOnError(t *testing.T) {
+	v := RequireVerifier{}
+	rt := &recordingT{}
+
+	v.NoError(rt, errUnexpected)
+
+	if len(rt.fatals) == 0 {
+		t.Fatal("expected a non-nil error to call FailNow (via Fatal)")
+	}
+}
+
+var errUnexpected = &testError{"unexpected"}
+
+type testError struct{ msg string }
+
+func (e *testError) Error() string { return e.msg }
@@ -0,0 +1,58 @@
+package apitest
+
+import (
+	"net/http"
+	"strings"
+)
+
+// Response wraps the outcome of a single APITest.Expect call so assertions can
+// be chained off it, e.g. apitest.New()...Expect(t).Assert(apitest.IsSuccess).
+type Response struct {
+	t        TestingT
+	verifier Verifier
+	request  *http.Request
+	response *http.Response
+}
+
+// Expect builds and executes the configured request, applying auth if one was
+// set via WithAuth, and returns a Response for asserting on the result.
+func (a *APITest) Expect(t TestingT) *Response {
+	req, err := http.NewRequest(a.method, a.url, strings.NewReader(a.body))
+	if !a.verifier.NoError(t, err, "failed to build request") {
+		return &Response{t: t, verifier: a.verifier, request: req}
+	}
+	req.Header = a.header.Clone()
+
+	if a.auth != nil {
+		if err := a.auth.Apply(req); !a.verifier.NoError(t, err, "failed to apply authenticator") {
+			return &Response{t: t, verifier: a.verifier, request: req}
+		}
+	}
+
+	client := a.client
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	res, err := client.Do(req)
+	if !a.verifier.NoError(t, err, "failed to execute request") {
+		return &Response{t: t, verifier: a.verifier, request: req}
+	}
+
+	return &Response{t: t, verifier: a.verifier, request: req, response: res}
+}
+
+// Assert runs fn against the response and request, reporting a failure
+// through the configured Verifier if fn returns an error. With a fail-fast
+// Verifier such as RequireVerifier, a failing assertion - e.g. IsSuccess -
+// aborts the test before any later Assert call in the chain runs.
+func (r *Response) Assert(fn Assert) *Response {
+	if r.response == nil {
+		// a prior step (building/sending the request) already failed and reported it
+		return r
+	}
+	if err := fn(r.response, r.request); err != nil {
+		r.verifier.Fail(r.t, err.Error())
+	}
+	return r
+}
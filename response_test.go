@@ -0,0 +1,89 @@
+package apitest
+
+import (
+	"net/http"
+	"runtime"
+	"testing"
+)
+
+// quietT is a minimal TestingT that records failures without making the
+// outer go test itself fail, so we can observe a (possibly failing) Assert
+// chain in isolation.
+type quietT struct {
+	errors []string
+}
+
+func (q *quietT) Errorf(format string, args ...interface{}) {
+	q.errors = append(q.errors, format)
+}
+
+func (q *quietT) Fatal(args ...interface{}) {
+	q.errors = append(q.errors, "fatal")
+}
+
+func (q *quietT) Fatalf(format string, args ...interface{}) {
+	q.errors = append(q.errors, format)
+}
+
+// goexitT records failures like quietT, but like *testing.T its Fatal and
+// Fatalf stop the calling goroutine via runtime.Goexit.
+type goexitT struct {
+	quietT
+}
+
+func (g *goexitT) Fatal(args ...interface{}) {
+	g.quietT.Fatal(args...)
+	runtime.Goexit()
+}
+
+func (g *goexitT) Fatalf(format string, args ...interface{}) {
+	g.quietT.Fatalf(format, args...)
+	runtime.Goexit()
+}
+
+func TestResponse_Assert_ChainContinuesByDefault(t *testing.T) {
+	secondRan := false
+	resp := &Response{
+		t:        &quietT{},
+		verifier: newTestifyVerifier(),
+		request:  &http.Request{},
+		response: &http.Response{StatusCode: http.StatusInternalServerError},
+	}
+
+	resp.Assert(IsSuccess).Assert(func(r *http.Response, req *http.Request) error {
+		secondRan = true
+		return nil
+	})
+
+	if !secondRan {
+		t.Fatal("expected the default (non fail-fast) verifier to let the chain continue after a failed assert")
+	}
+}
+
+func TestResponse_Assert_HaltsChainWithRequireVerifier(t *testing.T) {
+	secondRan := false
+	gt := &goexitT{}
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		resp := &Response{
+			t:        gt,
+			verifier: RequireVerifier{},
+			request:  &http.Request{},
+			response: &http.Response{StatusCode: http.StatusInternalServerError},
+		}
+
+		resp.Assert(IsSuccess).Assert(func(r *http.Response, req *http.Request) error {
+			secondRan = true
+			return nil
+		})
+	}()
+	<-done
+
+	if len(gt.errors) == 0 {
+		t.Fatal("expected the chain to fail: IsSuccess against a 500 status code")
+	}
+	if secondRan {
+		t.Fatal("expected RequireVerifier to abort the chain before the second Assert ran")
+	}
+}